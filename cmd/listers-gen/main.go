@@ -0,0 +1,167 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// listers-gen reads a small descriptor of {group, version, kind, namespaced}
+// tuples and emits the generated lister boilerplate that used to be
+// hand-maintained in pkg/client/legacylisters. Run it via
+// `hack/update-listers.sh`, which wraps this binary with the repo's
+// conventional input/output flags.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+)
+
+var (
+	inputDescriptor = flag.String("input-descriptor", "", "path to the JSON file describing the resources to generate listers for")
+	outputBase      = flag.String("output-base", "pkg/client/listers", "directory listers are emitted under, as <output-base>/<group>/<version>/<kind>.go")
+)
+
+func main() {
+	flag.Parse()
+	if *inputDescriptor == "" {
+		log.Fatal("--input-descriptor is required")
+	}
+
+	raw, err := ioutil.ReadFile(*inputDescriptor)
+	if err != nil {
+		log.Fatalf("reading descriptor: %v", err)
+	}
+	var descriptor descriptorFile
+	if err := json.Unmarshal(raw, &descriptor); err != nil {
+		log.Fatalf("parsing descriptor: %v", err)
+	}
+
+	pkgAlias := descriptor.APIPackage[strings.LastIndex(descriptor.APIPackage, "/")+1:]
+
+	byGroupVersion := map[string][]resource{}
+	for _, r := range descriptor.Resources {
+		key := filepath.Join(r.Group, r.Version)
+		byGroupVersion[key] = append(byGroupVersion[key], r)
+	}
+
+	for groupVersion, resources := range byGroupVersion {
+		outDir := filepath.Join(*outputBase, groupVersion)
+		if err := os.MkdirAll(outDir, 0755); err != nil {
+			log.Fatalf("creating %s: %v", outDir, err)
+		}
+		if err := writeGroupVersion(outDir, resources, descriptor.APIPackage, pkgAlias); err != nil {
+			log.Fatalf("generating %s: %v", groupVersion, err)
+		}
+	}
+}
+
+func writeGroupVersion(outDir string, resources []resource, apiPackage, pkgAlias string) error {
+	var expansionMarkers bytes.Buffer
+	for _, r := range resources {
+		tmpl := listerTemplate
+		if !r.Namespaced {
+			tmpl = clusterListerTemplate
+		}
+		if err := renderFile(filepath.Join(outDir, strings.ToLower(r.Kind)+".go"), tmpl, map[string]interface{}{
+			"Kind":          r.Kind,
+			"lowerKind":     lowerFirst(r.Kind),
+			"Version":       r.Version,
+			"APIPackage":    apiPackage,
+			"PkgAlias":      pkgAlias,
+			"LowerResource": r.LowerResource,
+		}); err != nil {
+			return err
+		}
+
+		// A hand-written <kind>_expansion.go preserved across regeneration may
+		// define ListerExpansion, NamespaceListerExpansion, or both; the
+		// generator must only emit a marker for whichever one it leaves out.
+		handWritten, err := handWrittenTypes(filepath.Join(outDir, strings.ToLower(r.Kind)+"_expansion.go"))
+		if err != nil {
+			return err
+		}
+		data := map[string]interface{}{
+			"Kind":                   r.Kind,
+			"EmitListerExpansion":    !handWritten[r.Kind+"ListerExpansion"],
+			"EmitNamespaceExpansion": r.Namespaced && !handWritten[r.Kind+"NamespaceListerExpansion"],
+		}
+		if err := template.Must(template.New("marker").Parse(expansionMarkerTemplate)).Execute(&expansionMarkers, data); err != nil {
+			return err
+		}
+	}
+
+	header := new(bytes.Buffer)
+	if err := template.Must(template.New("header").Parse(licenseHeader)).Execute(header, nil); err != nil {
+		return err
+	}
+	body := fmt.Sprintf("%spackage %s\n%s", header.String(), resources[0].Version, expansionMarkers.String())
+	return writeFormatted(filepath.Join(outDir, "expansion_generated.go"), body)
+}
+
+// handWrittenTypes returns the set of top-level type names declared in the
+// hand-written expansion file at path, or nil if the file does not exist.
+// It is a plain textual scan, not a Go parser, because the only thing the
+// generator needs to know is which marker interfaces it can skip.
+func handWrittenTypes(path string) (map[string]bool, error) {
+	raw, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	types := map[string]bool{}
+	for _, line := range strings.Split(string(raw), "\n") {
+		fields := strings.Fields(line)
+		if len(fields) >= 2 && fields[0] == "type" {
+			types[fields[1]] = true
+		}
+	}
+	return types, nil
+}
+
+func renderFile(path, tmpl string, data interface{}) error {
+	var header bytes.Buffer
+	if err := template.Must(template.New("header").Parse(licenseHeader)).Execute(&header, nil); err != nil {
+		return err
+	}
+	var body bytes.Buffer
+	if err := template.Must(template.New("lister").Parse(tmpl)).Execute(&body, data); err != nil {
+		return err
+	}
+	return writeFormatted(path, header.String()+body.String())
+}
+
+func writeFormatted(path, src string) error {
+	formatted, err := format.Source([]byte(src))
+	if err != nil {
+		return fmt.Errorf("gofmt %s: %v", path, err)
+	}
+	return ioutil.WriteFile(path, formatted, 0644)
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
@@ -0,0 +1,96 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+)
+
+func TestHandWrittenTypesMissingFile(t *testing.T) {
+	types, err := handWrittenTypes(filepath.Join(t.TempDir(), "pod_expansion.go"))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if types != nil {
+		t.Fatalf("expected nil for a missing file, got %v", types)
+	}
+}
+
+func TestHandWrittenTypesPartialOverride(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pod_expansion.go")
+	src := `package v1
+
+// PodListerExpansion allows custom methods to be added to PodLister.
+type PodListerExpansion interface {
+	ListByNode(nodeName string) ([]*v1.Pod, error)
+}
+`
+	if err := ioutil.WriteFile(path, []byte(src), 0644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+
+	types, err := handWrittenTypes(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !types["PodListerExpansion"] {
+		t.Errorf("expected PodListerExpansion to be detected as hand-written")
+	}
+	if types["PodNamespaceListerExpansion"] {
+		t.Errorf("PodNamespaceListerExpansion was not declared in the fixture, but was detected")
+	}
+}
+
+// TestWriteGroupVersionEmitsMissingNamespaceMarker is a regression test for a
+// bug where writeGroupVersion skipped the <Kind>NamespaceListerExpansion
+// marker whenever any <kind>_expansion.go existed, even if that file only
+// overrode <Kind>ListerExpansion. That left expansion_generated.go without a
+// type that pod.go's PodNamespaceListerExpansion embed needs.
+func TestWriteGroupVersionEmitsMissingNamespaceMarker(t *testing.T) {
+	dir := t.TempDir()
+	src := `package v1
+
+type PodListerExpansion interface{}
+`
+	if err := ioutil.WriteFile(filepath.Join(dir, "pod_expansion.go"), []byte(src), 0644); err != nil {
+		t.Fatalf("writing fixture: %v", err)
+	}
+
+	resources := []resource{{Group: "core", Version: "v1", Kind: "Pod", Namespaced: true, LowerResource: "pods"}}
+	if err := writeGroupVersion(dir, resources, "k8s.io/kubernetes/pkg/api/v1", "v1"); err != nil {
+		t.Fatalf("writeGroupVersion: %v", err)
+	}
+
+	generated, err := ioutil.ReadFile(filepath.Join(dir, "expansion_generated.go"))
+	if err != nil {
+		t.Fatalf("reading expansion_generated.go: %v", err)
+	}
+
+	types, err := handWrittenTypes(filepath.Join(dir, "expansion_generated.go"))
+	if err != nil {
+		t.Fatalf("scanning expansion_generated.go: %v", err)
+	}
+	if types["PodListerExpansion"] {
+		t.Errorf("expansion_generated.go should not redeclare the hand-written PodListerExpansion:\n%s", generated)
+	}
+	if !types["PodNamespaceListerExpansion"] {
+		t.Errorf("expansion_generated.go is missing PodNamespaceListerExpansion, which pod.go needs:\n%s", generated)
+	}
+}
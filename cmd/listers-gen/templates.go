@@ -0,0 +1,232 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+const licenseHeader = `/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+`
+
+// listerTemplate emits a namespaced lister: <Kind>Lister, <Kind>NamespaceLister.
+// Both are thin, typed wrappers over cache.GenericLister so that the
+// GetByKey/errors.NewNotFound/cast boilerplate is written once, not once per
+// generated type.
+const listerTemplate = `package {{.Version}}
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"{{.APIPackage}}"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// {{.Kind}}Lister helps list {{.Kind}}s.
+type {{.Kind}}Lister interface {
+	// List lists all {{.Kind}}s in the indexer.
+	List(selector labels.Selector) (ret []*{{.PkgAlias}}.{{.Kind}}, err error)
+	// {{.Kind}}s returns an object that can list and get {{.Kind}}s in a given namespace.
+	{{.Kind}}s(namespace string) {{.Kind}}NamespaceLister
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with New{{.Kind}}ListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	{{.Kind}}ListerExpansion
+}
+
+// {{.lowerKind}}Lister implements {{.Kind}}Lister.
+type {{.lowerKind}}Lister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// New{{.Kind}}Lister returns a new {{.Kind}}Lister.
+func New{{.Kind}}Lister(indexer cache.Indexer) {{.Kind}}Lister {
+	return &{{.lowerKind}}Lister{indexer: indexer}
+}
+
+// New{{.Kind}}ListerFromInformer returns a new {{.Kind}}Lister backed by informer,
+// so the same object can be used for both point-in-time reads (List, {{.Kind}}s)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func New{{.Kind}}ListerFromInformer(informer cache.SharedIndexInformer) {{.Kind}}Lister {
+	return &{{.lowerKind}}Lister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all {{.Kind}}s in the indexer.
+func (s *{{.lowerKind}}Lister) List(selector labels.Selector) (ret []*{{.PkgAlias}}.{{.Kind}}, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, {{.PkgAlias}}.Resource("{{.LowerResource}}")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*{{.PkgAlias}}.{{.Kind}}))
+	}
+	return ret, nil
+}
+
+// {{.Kind}}s returns an object that can list and get {{.Kind}}s in a given namespace.
+func (s *{{.lowerKind}}Lister) {{.Kind}}s(namespace string) {{.Kind}}NamespaceLister {
+	return {{.lowerKind}}NamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with New{{.Kind}}Lister instead.
+func (s *{{.lowerKind}}Lister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// {{.Kind}}NamespaceLister helps list and get {{.Kind}}s in a given namespace.
+type {{.Kind}}NamespaceLister interface {
+	// List lists all {{.Kind}}s in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*{{.PkgAlias}}.{{.Kind}}, err error)
+	// Get retrieves the {{.Kind}} from the indexer for a given namespace and name.
+	Get(name string) (*{{.PkgAlias}}.{{.Kind}}, error)
+	{{.Kind}}NamespaceListerExpansion
+}
+
+// {{.lowerKind}}NamespaceLister implements {{.Kind}}NamespaceLister.
+type {{.lowerKind}}NamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all {{.Kind}}s in the indexer for a given namespace.
+func (s {{.lowerKind}}NamespaceLister) List(selector labels.Selector) (ret []*{{.PkgAlias}}.{{.Kind}}, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, {{.PkgAlias}}.Resource("{{.LowerResource}}")).ByNamespace(s.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*{{.PkgAlias}}.{{.Kind}}))
+	}
+	return ret, nil
+}
+
+// Get retrieves the {{.Kind}} from the indexer for a given namespace and name.
+func (s {{.lowerKind}}NamespaceLister) Get(name string) (*{{.PkgAlias}}.{{.Kind}}, error) {
+	obj, err := cache.NewGenericLister(s.indexer, {{.PkgAlias}}.Resource("{{.LowerResource}}")).ByNamespace(s.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*{{.PkgAlias}}.{{.Kind}}), nil
+}
+`
+
+// clusterListerTemplate emits a cluster-scoped lister (no namespace lister), used for Namespace itself.
+const clusterListerTemplate = `package {{.Version}}
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"{{.APIPackage}}"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// {{.Kind}}Lister helps list {{.Kind}}s.
+// {{.Kind}}s are cluster-scoped, so there is no per-namespace lister.
+type {{.Kind}}Lister interface {
+	// List lists all {{.Kind}}s in the indexer.
+	List(selector labels.Selector) (ret []*{{.PkgAlias}}.{{.Kind}}, err error)
+	// Get retrieves the {{.Kind}} from the indexer for a given name.
+	Get(name string) (*{{.PkgAlias}}.{{.Kind}}, error)
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with New{{.Kind}}ListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	{{.Kind}}ListerExpansion
+}
+
+// {{.lowerKind}}Lister implements {{.Kind}}Lister.
+type {{.lowerKind}}Lister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// New{{.Kind}}Lister returns a new {{.Kind}}Lister.
+func New{{.Kind}}Lister(indexer cache.Indexer) {{.Kind}}Lister {
+	return &{{.lowerKind}}Lister{indexer: indexer}
+}
+
+// New{{.Kind}}ListerFromInformer returns a new {{.Kind}}Lister backed by informer,
+// so the same object can be used for both point-in-time reads (List, Get)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func New{{.Kind}}ListerFromInformer(informer cache.SharedIndexInformer) {{.Kind}}Lister {
+	return &{{.lowerKind}}Lister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all {{.Kind}}s in the indexer.
+func (s *{{.lowerKind}}Lister) List(selector labels.Selector) (ret []*{{.PkgAlias}}.{{.Kind}}, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, {{.PkgAlias}}.Resource("{{.LowerResource}}")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*{{.PkgAlias}}.{{.Kind}}))
+	}
+	return ret, nil
+}
+
+// Get retrieves the {{.Kind}} from the indexer for a given name.
+func (s *{{.lowerKind}}Lister) Get(name string) (*{{.PkgAlias}}.{{.Kind}}, error) {
+	obj, err := cache.NewGenericLister(s.indexer, {{.PkgAlias}}.Resource("{{.LowerResource}}")).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*{{.PkgAlias}}.{{.Kind}}), nil
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with New{{.Kind}}Lister instead.
+func (s *{{.lowerKind}}Lister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+`
+
+// expansionMarkerTemplate is appended to expansion_generated.go for each of
+// {{.Kind}}ListerExpansion/{{.Kind}}NamespaceListerExpansion that the
+// resource's hand-written <kind>_expansion.go does not itself define, so the
+// interface embeds in <kind>.go always resolve. The two are gated
+// independently: a hand-written file may override only one of them (see
+// pod_expansion.go, which defines PodListerExpansion but leaves
+// PodNamespaceListerExpansion to this marker).
+const expansionMarkerTemplate = `{{if .EmitListerExpansion}}
+// {{.Kind}}ListerExpansion allows custom methods to be added to {{.Kind}}Lister.
+type {{.Kind}}ListerExpansion interface{}
+{{end}}{{if .EmitNamespaceExpansion}}
+// {{.Kind}}NamespaceListerExpansion allows custom methods to be added to {{.Kind}}NamespaceLister.
+type {{.Kind}}NamespaceListerExpansion interface{}
+{{end}}`
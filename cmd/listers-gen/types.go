@@ -0,0 +1,43 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+// resource describes one API type that should get a generated lister. The
+// descriptor is intentionally tiny: the generator does not parse Go source
+// for `// +genclient` / `// +listers` markers itself (that's left to a
+// gengo-based arguments parser in a follow-up), it just walks this list.
+type resource struct {
+	// Group is the API group directory the lister is emitted under, e.g. "core".
+	Group string `json:"group"`
+	// Version is the API version directory, e.g. "v1".
+	Version string `json:"version"`
+	// Kind is the Go type name of the resource, e.g. "Pod".
+	Kind string `json:"kind"`
+	// Namespaced controls whether a <Kind>NamespaceLister is emitted.
+	Namespaced bool `json:"namespaced"`
+	// LowerResource is the lower-cased resource name used in
+	// errors.NewNotFound, e.g. "pod" or "persistentvolumeclaims".
+	LowerResource string `json:"lowerResource"`
+}
+
+// descriptorFile is the top-level shape of the JSON file passed via
+// --input-descriptor.
+type descriptorFile struct {
+	// APIPackage is the import path the generated files read the Kind types from.
+	APIPackage string     `json:"apiPackage"`
+	Resources  []resource `json:"resources"`
+}
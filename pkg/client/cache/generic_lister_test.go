@@ -0,0 +1,62 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeRuntimeObject is the minimal runtime.Object this test needs; the real
+// generated API types carry the real DeepCopyObject, but GenericLister never
+// looks past the interface.
+type fakeRuntimeObject struct {
+	key string
+}
+
+func (f *fakeRuntimeObject) GetObjectKind() schema.ObjectKind { return nil }
+func (f *fakeRuntimeObject) DeepCopyObject() runtime.Object   { return &fakeRuntimeObject{key: f.key} }
+func (f *fakeRuntimeObject) Key() string                      { return f.key }
+
+func TestGenericListerGetNotFound(t *testing.T) {
+	indexer := newFakeIndexer()
+	lister := NewGenericLister(indexer, schema.GroupResource{Resource: "widgets"})
+
+	_, err := lister.Get("missing")
+	if err == nil || !errors.IsNotFound(err) {
+		t.Fatalf("expected a NotFound error, got %v", err)
+	}
+}
+
+func TestGenericListerGetFound(t *testing.T) {
+	indexer := newFakeIndexer()
+	if err := indexer.Add(&fakeRuntimeObject{key: "widget-1"}); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+	lister := NewGenericLister(indexer, schema.GroupResource{Resource: "widgets"})
+
+	obj, err := lister.Get("widget-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if obj.(*fakeRuntimeObject).key != "widget-1" {
+		t.Errorf("got the wrong object back: %v", obj)
+	}
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"sync"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// SelectorIndexFunc extracts the label selector an object uses to pick its
+// targets (e.g. a Service's or ReplicationController's spec.selector) from
+// the object. It returns nil if the object has no selector.
+type SelectorIndexFunc func(obj interface{}) labels.Selector
+
+// SelectorIndexer is implemented by an Indexer that was wrapped with
+// NewSelectorIndex. Callers that only have a plain cache.Indexer should type
+// assert for this interface and fall back to a full scan if it is absent.
+type SelectorIndexer interface {
+	// MatchingKeysForLabels returns the store keys of every indexed object
+	// that could match lbls. The result is a safe superset, not an exact
+	// match: the caller still needs a final selector.Matches(lbls) pass to
+	// discard objects that share one requirement with lbls but differ on
+	// another, or whose selector couldn't be posted at all (see addToPostings).
+	MatchingKeysForLabels(lbls labels.Set) (sets.String, error)
+}
+
+// SelectorIndex decorates an Indexer, maintaining an inverted index from
+// "key=value" requirement pairs to the store keys of objects whose
+// SelectorIndexFunc-extracted selector requires that pair. This turns the
+// O(N) "scan every object and call selector.Matches" pattern the pre-indexed
+// listers used (see GetPodServices, GetPodControllers) into an O(requirement
+// pairs) lookup followed by a final selector.Matches pass over the much
+// smaller candidate set it returns.
+type SelectorIndex struct {
+	Indexer
+
+	selectorFunc SelectorIndexFunc
+
+	lock     sync.RWMutex
+	postings map[string]sets.String // "key=value" -> store keys
+	// unindexable holds the store keys of objects whose selector has at
+	// least one requirement (Exists, DoesNotExist, NotIn, ...) that can't be
+	// represented as a "key=value" posting. They are returned as candidates
+	// for every query rather than silently dropped.
+	unindexable sets.String
+}
+
+// NewSelectorIndex wraps indexer, deriving each stored object's selector via
+// selectorFunc.
+func NewSelectorIndex(indexer Indexer, selectorFunc SelectorIndexFunc) *SelectorIndex {
+	s := &SelectorIndex{
+		Indexer:      indexer,
+		selectorFunc: selectorFunc,
+		postings:     map[string]sets.String{},
+		unindexable:  sets.String{},
+	}
+	for _, obj := range indexer.List() {
+		if key, err := DeletionHandlingMetaNamespaceKeyFunc(obj); err == nil {
+			s.addToPostings(key, obj)
+		}
+	}
+	return s
+}
+
+func (s *SelectorIndex) Add(obj interface{}) error {
+	if err := s.Indexer.Add(obj); err != nil {
+		return err
+	}
+	key, err := DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.addToPostings(key, obj)
+	return nil
+}
+
+func (s *SelectorIndex) Update(obj interface{}) error {
+	if err := s.Indexer.Update(obj); err != nil {
+		return err
+	}
+	key, err := DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.removeFromPostings(key)
+	s.addToPostings(key, obj)
+	return nil
+}
+
+func (s *SelectorIndex) Delete(obj interface{}) error {
+	if err := s.Indexer.Delete(obj); err != nil {
+		return err
+	}
+	key, err := DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return err
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.removeFromPostings(key)
+	return nil
+}
+
+// addToPostings must be called with s.lock held.
+func (s *SelectorIndex) addToPostings(key string, obj interface{}) {
+	selector := s.selectorFunc(obj)
+	if selector == nil {
+		// No selector at all (e.g. a headless Service): matches nothing, so
+		// it must never be a candidate.
+		return
+	}
+	if selector.Empty() {
+		// A present-but-empty selector (e.g. a Service with spec.selector
+		// set to {}) has zero requirements, so by definition it matches
+		// every possible label set. There is no "key=value" pair to post
+		// it under, so track it the same way as an unindexable selector:
+		// always a candidate. Callers that want an empty selector to match
+		// nothing instead (see ReplicationController's GetPodControllers)
+		// already re-check selector.Empty() themselves before accepting a
+		// candidate, so this is safe for them too.
+		s.unindexable.Insert(key)
+		return
+	}
+	requirements, selectable := selector.Requirements()
+	if !selectable {
+		return
+	}
+	posted := false
+	for _, r := range requirements {
+		// Only Equals/DoubleEquals/In requirements pin the key to a
+		// specific, enumerable set of values; Exists, DoesNotExist, NotIn,
+		// and the ordering operators don't, so there is no "key=value" pair
+		// to post them under.
+		if r.Operator() != selection.Equals && r.Operator() != selection.DoubleEquals && r.Operator() != selection.In {
+			continue
+		}
+		for _, v := range r.Values().List() {
+			posting := r.Key() + "=" + v
+			if s.postings[posting] == nil {
+				s.postings[posting] = sets.String{}
+			}
+			s.postings[posting].Insert(key)
+			posted = true
+		}
+	}
+	if !posted {
+		s.unindexable.Insert(key)
+	}
+}
+
+// removeFromPostings must be called with s.lock held.
+func (s *SelectorIndex) removeFromPostings(key string) {
+	for posting, keys := range s.postings {
+		keys.Delete(key)
+		if keys.Len() == 0 {
+			delete(s.postings, posting)
+		}
+	}
+	s.unindexable.Delete(key)
+}
+
+// MatchingKeysForLabels returns the union of the posting lists for every
+// label lbls carries, plus every unindexable key (which covers both a
+// present-but-empty selector, matching everything, and a selector with a
+// non-equality requirement that has no "key=value" pair to post). Any
+// object whose selector requires only pairs present in lbls necessarily
+// has at least one requirement posted under one of these keys or is in
+// unindexable, so the union is a safe, usually tiny, candidate set; the
+// caller still runs selector.Matches(lbls) over it to discard objects that
+// share one requirement pair but differ on another.
+func (s *SelectorIndex) MatchingKeysForLabels(lbls labels.Set) (sets.String, error) {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	candidates := sets.String{}
+	for k, v := range lbls {
+		if keys, ok := s.postings[k+"="+v]; ok {
+			candidates.Insert(keys.List()...)
+		}
+	}
+	candidates.Insert(s.unindexable.List()...)
+	return candidates, nil
+}
@@ -0,0 +1,246 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+import (
+	"fmt"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+)
+
+// keyed is implemented by every fake object fakeIndexer stores, so it has a
+// store key to index by without needing to know the concrete type.
+type keyed interface {
+	Key() string
+}
+
+// fakeObject is the minimal shape addToPostings/selectorFunc need for these
+// tests: a store key and the selector (if any) it is indexed by. It embeds
+// ObjectMeta, rather than just carrying a bare key string, because
+// SelectorIndex.Add derives its posting key via
+// DeletionHandlingMetaNamespaceKeyFunc, which requires a real metav1.Object
+// accessor.
+type fakeObject struct {
+	metav1.ObjectMeta
+	selector labels.Selector
+}
+
+func (f *fakeObject) Key() string {
+	if f.Namespace == "" {
+		return f.Name
+	}
+	return f.Namespace + "/" + f.Name
+}
+
+func fakeSelectorFunc(obj interface{}) labels.Selector {
+	return obj.(*fakeObject).selector
+}
+
+// fakeIndexer is a minimal in-memory Indexer sufficient to drive
+// SelectorIndex and GenericLister in tests, without pulling in the real
+// Store/Indexer implementation this package otherwise assumes is vendored
+// alongside it.
+type fakeIndexer struct {
+	objects map[string]interface{}
+}
+
+func newFakeIndexer() *fakeIndexer {
+	return &fakeIndexer{objects: map[string]interface{}{}}
+}
+
+func (f *fakeIndexer) Add(obj interface{}) error {
+	f.objects[obj.(keyed).Key()] = obj
+	return nil
+}
+
+func (f *fakeIndexer) Update(obj interface{}) error {
+	return f.Add(obj)
+}
+
+func (f *fakeIndexer) Delete(obj interface{}) error {
+	delete(f.objects, obj.(keyed).Key())
+	return nil
+}
+
+func (f *fakeIndexer) List() []interface{} {
+	out := make([]interface{}, 0, len(f.objects))
+	for _, obj := range f.objects {
+		out = append(out, obj)
+	}
+	return out
+}
+
+func (f *fakeIndexer) ListKeys() []string {
+	out := make([]string, 0, len(f.objects))
+	for k := range f.objects {
+		out = append(out, k)
+	}
+	return out
+}
+
+func (f *fakeIndexer) Get(obj interface{}) (interface{}, bool, error) {
+	return f.GetByKey(obj.(keyed).Key())
+}
+
+func (f *fakeIndexer) GetByKey(key string) (interface{}, bool, error) {
+	obj, exists := f.objects[key]
+	return obj, exists, nil
+}
+
+func (f *fakeIndexer) Replace(objs []interface{}, resourceVersion string) error {
+	f.objects = map[string]interface{}{}
+	for _, obj := range objs {
+		if err := f.Add(obj); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (f *fakeIndexer) Resync() error { return nil }
+
+func (f *fakeIndexer) Index(indexName string, obj interface{}) ([]interface{}, error) {
+	return nil, fmt.Errorf("fakeIndexer does not support field indexes")
+}
+
+func (f *fakeIndexer) IndexKeys(indexName, indexKey string) ([]string, error) {
+	return nil, fmt.Errorf("fakeIndexer does not support field indexes")
+}
+
+func (f *fakeIndexer) ListIndexFuncValues(indexName string) []string { return nil }
+
+func (f *fakeIndexer) ByIndex(indexName, indexKey string) ([]interface{}, error) {
+	return nil, fmt.Errorf("fakeIndexer does not support field indexes")
+}
+
+func (f *fakeIndexer) GetIndexers() Indexers { return Indexers{} }
+
+func (f *fakeIndexer) AddIndexers(newIndexers Indexers) error {
+	return fmt.Errorf("fakeIndexer does not support field indexes")
+}
+
+func TestMatchingKeysForLabelsEqualitySelector(t *testing.T) {
+	indexer := newFakeIndexer()
+	idx := NewSelectorIndex(indexer, fakeSelectorFunc)
+
+	svc := &fakeObject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "svc"},
+		selector:   labels.SelectorFromSet(labels.Set{"app": "frontend"}),
+	}
+	if err := idx.Add(svc); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	candidates, err := idx.MatchingKeysForLabels(labels.Set{"app": "frontend", "tier": "web"})
+	if err != nil {
+		t.Fatalf("MatchingKeysForLabels: %v", err)
+	}
+	if !candidates.Has("default/svc") {
+		t.Errorf("expected default/svc to be a candidate, got %v", candidates.List())
+	}
+
+	candidates, err = idx.MatchingKeysForLabels(labels.Set{"app": "backend"})
+	if err != nil {
+		t.Fatalf("MatchingKeysForLabels: %v", err)
+	}
+	if candidates.Has("default/svc") {
+		t.Errorf("default/svc should not be a candidate for a non-matching label set, got %v", candidates.List())
+	}
+}
+
+// TestMatchingKeysForLabelsEmptySelector is a regression test: a Service
+// with spec.selector set to {} (present but empty, as opposed to nil) has
+// no requirements, so it matches every pod the same way GetPodServices'
+// scan does, and must be a candidate for every label set.
+func TestMatchingKeysForLabelsEmptySelector(t *testing.T) {
+	indexer := newFakeIndexer()
+	idx := NewSelectorIndex(indexer, fakeSelectorFunc)
+
+	svc := &fakeObject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "catch-all"},
+		selector:   labels.Set{}.AsSelectorPreValidated(),
+	}
+	if err := idx.Add(svc); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	candidates, err := idx.MatchingKeysForLabels(labels.Set{"app": "anything"})
+	if err != nil {
+		t.Fatalf("MatchingKeysForLabels: %v", err)
+	}
+	if !candidates.Has("default/catch-all") {
+		t.Errorf("a present-but-empty selector must be a candidate for every label set, got %v", candidates.List())
+	}
+}
+
+// TestMatchingKeysForLabelsNonEqualitySelector is a regression test: a
+// DaemonSet selected purely by Exists/NotIn-style requirements has no
+// "key=value" pair to post, so it used to be silently absent from every
+// MatchingKeysForLabels result regardless of the query.
+func TestMatchingKeysForLabelsNonEqualitySelector(t *testing.T) {
+	req, err := labels.NewRequirement("env", selection.Exists, nil)
+	if err != nil {
+		t.Fatalf("building requirement: %v", err)
+	}
+	selector := labels.NewSelector().Add(*req)
+
+	indexer := newFakeIndexer()
+	idx := NewSelectorIndex(indexer, fakeSelectorFunc)
+
+	ds := &fakeObject{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "ds"},
+		selector:   selector,
+	}
+	if err := idx.Add(ds); err != nil {
+		t.Fatalf("Add: %v", err)
+	}
+
+	candidates, err := idx.MatchingKeysForLabels(labels.Set{"env": "prod"})
+	if err != nil {
+		t.Fatalf("MatchingKeysForLabels: %v", err)
+	}
+	if !candidates.Has("default/ds") {
+		t.Errorf("an Exists-only selector must still surface as a candidate, got %v", candidates.List())
+	}
+}
+
+// BenchmarkMatchingKeysForLabels demonstrates the sub-linear behavior
+// SelectorIndex exists for: lookup cost should not grow with the number of
+// unrelated objects in the indexer.
+func BenchmarkMatchingKeysForLabels(b *testing.B) {
+	indexer := newFakeIndexer()
+	idx := NewSelectorIndex(indexer, fakeSelectorFunc)
+	for i := 0; i < 10000; i++ {
+		obj := &fakeObject{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: fmt.Sprintf("svc-%d", i)},
+			selector:   labels.SelectorFromSet(labels.Set{"app": fmt.Sprintf("app-%d", i)}),
+		}
+		if err := idx.Add(obj); err != nil {
+			b.Fatalf("Add: %v", err)
+		}
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if _, err := idx.MatchingKeysForLabels(labels.Set{"app": "app-42"}); err != nil {
+			b.Fatalf("MatchingKeysForLabels: %v", err)
+		}
+	}
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cache
+
+// SharedIndexInformer maintains a local cache populated by a Reflector and
+// delivers notifications about changes to that cache to registered
+// handlers. There is no other declaration of SharedIndexInformer in this
+// tree (informers haven't landed here yet), so this one is authoritative;
+// its method set otherwise matches the upstream informer so that a real
+// implementation, once vendored, only needs to grow the one deliberate
+// extension noted on AddEventHandler below.
+type SharedIndexInformer interface {
+	// GetIndexer returns the informer's backing Indexer, which a lister can
+	// be built on top of for point-in-time reads.
+	GetIndexer() Indexer
+	// GetStore returns the informer's backing Store.
+	GetStore() Store
+	// Run starts and runs the informer, returning after it stops. The
+	// informer is stopped when stopCh is closed.
+	Run(stopCh <-chan struct{})
+	// HasSynced returns true once the informer's store has been populated
+	// with the initial full list of objects.
+	HasSynced() bool
+	// AddIndexers adds indexers to the informer's backing Indexer before it
+	// starts. It returns an error if the informer has already started.
+	AddIndexers(indexers Indexers) error
+	// AddEventHandler registers handler to be called on every subsequent
+	// add/update/delete of the informer's contents. Unlike the upstream
+	// informer's AddEventHandler (which returns nothing at this vintage),
+	// this one returns a Registration so Subscribe callers can deregister;
+	// that is the one deliberate extension over the real method set.
+	AddEventHandler(handler ResourceEventHandler) Registration
+}
+
+// ResourceEventHandler reacts to add/update/delete notifications about
+// objects an informer tracks.
+type ResourceEventHandler interface {
+	OnAdd(obj interface{})
+	OnUpdate(oldObj, newObj interface{})
+	OnDelete(obj interface{})
+}
+
+// Registration is returned from AddEventHandler and can be used to remove
+// that handler from the informer it was registered with.
+type Registration interface {
+	Stop() error
+}
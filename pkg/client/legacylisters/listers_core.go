@@ -14,288 +14,338 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
+// Package listers is deprecated. The listers below are kept only so that
+// controllers written against the pre-1.5 API keep compiling; they are thin
+// wrappers over the generated listers in
+// k8s.io/kubernetes/pkg/client/listers/core/v1, which is where new code
+// should go. See hack/update-listers.sh for how those are produced.
 package listers
 
 import (
-	"fmt"
-
-	"k8s.io/apimachinery/pkg/api/errors"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
-	"k8s.io/kubernetes/pkg/api"
 	"k8s.io/kubernetes/pkg/api/v1"
 	"k8s.io/kubernetes/pkg/client/cache"
+	corev1listers "k8s.io/kubernetes/pkg/client/listers/core/v1"
 )
 
-//  TODO: generate these classes and methods for all resources of interest using
-// a script.  Can use "go generate" once 1.4 is supported by all users.
-
-// Lister makes an Index have the List method.  The Stores must contain only the expected type
-// Example:
-// s := cache.NewStore()
-// lw := cache.ListWatch{Client: c, FieldSelector: sel, Resource: "pods"}
-// r := cache.NewReflector(lw, &api.Pod{}, s).Run()
-// l := StoreToPodLister{s}
-// l.List()
-
 // StoreToPodLister helps list pods
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.PodLister instead.
 type StoreToPodLister struct {
-	Indexer cache.Indexer
+	Indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewStoreToPodListerFromInformer attaches informer to the returned lister
+// so that, in addition to List/Pods, it can also be passed to controller
+// code that wants change notifications via Subscribe.
+func NewStoreToPodListerFromInformer(informer cache.SharedIndexInformer) *StoreToPodLister {
+	return &StoreToPodLister{Indexer: informer.GetIndexer(), informer: informer}
 }
 
 func (s *StoreToPodLister) List(selector labels.Selector) (ret []*v1.Pod, err error) {
-	err = cache.ListAll(s.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.Pod))
-	})
-	return ret, err
+	return corev1listers.NewPodLister(s.Indexer).List(selector)
 }
 
 func (s *StoreToPodLister) Pods(namespace string) storePodsNamespacer {
 	return storePodsNamespacer{Indexer: s.Indexer, namespace: namespace}
 }
 
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if s was built with NewStoreToPodListerFromInformer; otherwise it is
+// a no-op that returns nil.
+func (s *StoreToPodLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// NewStoreToPodListerWithFieldIndexes returns a StoreToPodLister whose
+// Indexer has the "spec.nodeName" and "status.phase" indexes ListByNode and
+// ListByPhase use registered on it.
+func NewStoreToPodListerWithFieldIndexes(indexer cache.Indexer) (*StoreToPodLister, error) {
+	if _, err := corev1listers.NewPodListerWithFieldIndexes(indexer); err != nil {
+		return nil, err
+	}
+	return &StoreToPodLister{Indexer: indexer}, nil
+}
+
+// ListByNode lists every pod with spec.nodeName == nodeName. See
+// corev1listers.PodLister.ListByNode for the index/fallback behavior.
+func (s *StoreToPodLister) ListByNode(nodeName string) ([]*v1.Pod, error) {
+	return corev1listers.NewPodLister(s.Indexer).ListByNode(nodeName)
+}
+
+// ListByPhase lists every pod whose status.phase == phase. See
+// corev1listers.PodLister.ListByPhase for the index/fallback behavior.
+func (s *StoreToPodLister) ListByPhase(phase v1.PodPhase) ([]*v1.Pod, error) {
+	return corev1listers.NewPodLister(s.Indexer).ListByPhase(phase)
+}
+
 type storePodsNamespacer struct {
 	Indexer   cache.Indexer
 	namespace string
 }
 
 func (s storePodsNamespacer) List(selector labels.Selector) (ret []*v1.Pod, err error) {
-	err = cache.ListAllByNamespace(s.Indexer, s.namespace, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.Pod))
-	})
-	return ret, err
+	return corev1listers.NewPodLister(s.Indexer).Pods(s.namespace).List(selector)
 }
 
 func (s storePodsNamespacer) Get(name string) (*v1.Pod, error) {
-	obj, exists, err := s.Indexer.GetByKey(s.namespace + "/" + name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("pod"), name)
-	}
-	return obj.(*v1.Pod), nil
+	return corev1listers.NewPodLister(s.Indexer).Pods(s.namespace).Get(name)
 }
 
 // StoreToServiceLister helps list services
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.ServiceLister instead.
 type StoreToServiceLister struct {
-	Indexer cache.Indexer
+	Indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewStoreToServiceListerFromInformer attaches informer to the returned
+// lister so that, in addition to List/Services, it can also be passed to
+// controller code that wants change notifications via Subscribe.
+func NewStoreToServiceListerFromInformer(informer cache.SharedIndexInformer) *StoreToServiceLister {
+	return &StoreToServiceLister{Indexer: informer.GetIndexer(), informer: informer}
 }
 
 func (s *StoreToServiceLister) List(selector labels.Selector) (ret []*v1.Service, err error) {
-	err = cache.ListAll(s.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.Service))
-	})
-	return ret, err
+	return corev1listers.NewServiceLister(s.Indexer).List(selector)
 }
 
 func (s *StoreToServiceLister) Services(namespace string) storeServicesNamespacer {
 	return storeServicesNamespacer{s.Indexer, namespace}
 }
 
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if s was built with NewStoreToServiceListerFromInformer; otherwise
+// it is a no-op that returns nil.
+func (s *StoreToServiceLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// NewStoreToServiceListerWithSelectorIndex returns a StoreToServiceLister
+// whose Indexer is wrapped in a cache.SelectorIndex, so GetPodServicesIndexed
+// can look services up by a pod's labels instead of scanning every service
+// in the namespace.
+func NewStoreToServiceListerWithSelectorIndex(indexer cache.Indexer) *StoreToServiceLister {
+	return &StoreToServiceLister{Indexer: cache.NewSelectorIndex(indexer, corev1listers.ServiceSelectorIndexFunc)}
+}
+
 type storeServicesNamespacer struct {
 	indexer   cache.Indexer
 	namespace string
 }
 
 func (s storeServicesNamespacer) List(selector labels.Selector) (ret []*v1.Service, err error) {
-	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.Service))
-	})
-	return ret, err
+	return corev1listers.NewServiceLister(s.indexer).Services(s.namespace).List(selector)
 }
 
 func (s storeServicesNamespacer) Get(name string) (*v1.Service, error) {
-	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("service"), name)
-	}
-	return obj.(*v1.Service), nil
+	return corev1listers.NewServiceLister(s.indexer).Services(s.namespace).Get(name)
 }
 
+// GetPodServices returns a list of services that match a pod.
+//
 // TODO: Move this back to scheduler as a helper function that takes a Store,
 // rather than a method of StoreToServiceLister.
 func (s *StoreToServiceLister) GetPodServices(pod *v1.Pod) (services []*v1.Service, err error) {
-	allServices, err := s.Services(pod.Namespace).List(labels.Everything())
-	if err != nil {
-		return nil, err
-	}
-
-	for i := range allServices {
-		service := allServices[i]
-		if service.Spec.Selector == nil {
-			// services with nil selectors match nothing, not everything.
-			continue
-		}
-		selector := labels.Set(service.Spec.Selector).AsSelectorPreValidated()
-		if selector.Matches(labels.Set(pod.Labels)) {
-			services = append(services, service)
-		}
-	}
+	return corev1listers.NewServiceLister(s.Indexer).GetPodServices(pod)
+}
 
-	return services, nil
+// GetPodServicesIndexed is like GetPodServices, but consults a
+// cache.SelectorIndex on s.Indexer when one is installed instead of scanning
+// every service in the namespace.
+func (s *StoreToServiceLister) GetPodServicesIndexed(pod *v1.Pod) (services []*v1.Service, err error) {
+	return corev1listers.NewServiceLister(s.Indexer).GetPodServicesIndexed(pod)
 }
 
 // StoreToReplicationControllerLister helps list rcs
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.ReplicationControllerLister instead.
 type StoreToReplicationControllerLister struct {
-	Indexer cache.Indexer
+	Indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewStoreToReplicationControllerListerFromInformer attaches informer to the
+// returned lister so that, in addition to List/ReplicationControllers, it
+// can also be passed to controller code that wants change notifications via
+// Subscribe.
+func NewStoreToReplicationControllerListerFromInformer(informer cache.SharedIndexInformer) *StoreToReplicationControllerLister {
+	return &StoreToReplicationControllerLister{Indexer: informer.GetIndexer(), informer: informer}
 }
 
 func (s *StoreToReplicationControllerLister) List(selector labels.Selector) (ret []*v1.ReplicationController, err error) {
-	err = cache.ListAll(s.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.ReplicationController))
-	})
-	return ret, err
+	return corev1listers.NewReplicationControllerLister(s.Indexer).List(selector)
 }
 
 func (s *StoreToReplicationControllerLister) ReplicationControllers(namespace string) storeReplicationControllersNamespacer {
 	return storeReplicationControllersNamespacer{s.Indexer, namespace}
 }
 
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if s was built with
+// NewStoreToReplicationControllerListerFromInformer; otherwise it is a no-op
+// that returns nil.
+func (s *StoreToReplicationControllerLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// NewStoreToReplicationControllerListerWithSelectorIndex returns a
+// StoreToReplicationControllerLister whose Indexer is wrapped in a
+// cache.SelectorIndex, so GetPodControllersIndexed can look replication
+// controllers up by a pod's labels instead of scanning every replication
+// controller in the namespace.
+func NewStoreToReplicationControllerListerWithSelectorIndex(indexer cache.Indexer) *StoreToReplicationControllerLister {
+	return &StoreToReplicationControllerLister{Indexer: cache.NewSelectorIndex(indexer, corev1listers.ReplicationControllerSelectorIndexFunc)}
+}
+
 type storeReplicationControllersNamespacer struct {
 	indexer   cache.Indexer
 	namespace string
 }
 
 func (s storeReplicationControllersNamespacer) List(selector labels.Selector) (ret []*v1.ReplicationController, err error) {
-	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.ReplicationController))
-	})
-	return ret, err
+	return corev1listers.NewReplicationControllerLister(s.indexer).ReplicationControllers(s.namespace).List(selector)
 }
 
 func (s storeReplicationControllersNamespacer) Get(name string) (*v1.ReplicationController, error) {
-	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("replicationcontroller"), name)
-	}
-	return obj.(*v1.ReplicationController), nil
+	return corev1listers.NewReplicationControllerLister(s.indexer).ReplicationControllers(s.namespace).Get(name)
 }
 
 // GetPodControllers returns a list of replication controllers managing a pod. Returns an error only if no matching controllers are found.
 func (s *StoreToReplicationControllerLister) GetPodControllers(pod *v1.Pod) (controllers []*v1.ReplicationController, err error) {
-	if len(pod.Labels) == 0 {
-		err = fmt.Errorf("no controllers found for pod %v because it has no labels", pod.Name)
-		return
-	}
-
-	key := &v1.ReplicationController{ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace}}
-	items, err := s.Indexer.Index(cache.NamespaceIndex, key)
-	if err != nil {
-		return
-	}
-
-	for _, m := range items {
-		rc := m.(*v1.ReplicationController)
-		selector := labels.Set(rc.Spec.Selector).AsSelectorPreValidated()
+	return corev1listers.NewReplicationControllerLister(s.Indexer).GetPodControllers(pod)
+}
 
-		// If an rc with a nil or empty selector creeps in, it should match nothing, not everything.
-		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
-			continue
-		}
-		controllers = append(controllers, rc)
-	}
-	if len(controllers) == 0 {
-		err = fmt.Errorf("could not find controller for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
-	}
-	return
+// GetPodControllersIndexed is like GetPodControllers, but consults a
+// cache.SelectorIndex on s.Indexer when one is installed instead of scanning
+// every replication controller in the namespace.
+func (s *StoreToReplicationControllerLister) GetPodControllersIndexed(pod *v1.Pod) (controllers []*v1.ReplicationController, err error) {
+	return corev1listers.NewReplicationControllerLister(s.Indexer).GetPodControllersIndexed(pod)
 }
 
 // StoreToServiceAccountLister helps list service accounts
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.ServiceAccountLister instead.
 type StoreToServiceAccountLister struct {
-	Indexer cache.Indexer
+	Indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewStoreToServiceAccountListerFromInformer attaches informer to the
+// returned lister so that, in addition to List/ServiceAccounts, it can also
+// be passed to controller code that wants change notifications via
+// Subscribe.
+func NewStoreToServiceAccountListerFromInformer(informer cache.SharedIndexInformer) *StoreToServiceAccountLister {
+	return &StoreToServiceAccountLister{Indexer: informer.GetIndexer(), informer: informer}
 }
 
 func (s *StoreToServiceAccountLister) List(selector labels.Selector) (ret []*v1.ServiceAccount, err error) {
-	err = cache.ListAll(s.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.ServiceAccount))
-	})
-	return ret, err
+	return corev1listers.NewServiceAccountLister(s.Indexer).List(selector)
 }
 
 func (s *StoreToServiceAccountLister) ServiceAccounts(namespace string) storeServiceAccountsNamespacer {
 	return storeServiceAccountsNamespacer{s.Indexer, namespace}
 }
 
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if s was built with NewStoreToServiceAccountListerFromInformer;
+// otherwise it is a no-op that returns nil.
+func (s *StoreToServiceAccountLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
 type storeServiceAccountsNamespacer struct {
 	indexer   cache.Indexer
 	namespace string
 }
 
 func (s storeServiceAccountsNamespacer) List(selector labels.Selector) (ret []*v1.ServiceAccount, err error) {
-	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.ServiceAccount))
-	})
-	return ret, err
+	return corev1listers.NewServiceAccountLister(s.indexer).ServiceAccounts(s.namespace).List(selector)
 }
 
 func (s storeServiceAccountsNamespacer) Get(name string) (*v1.ServiceAccount, error) {
-	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("serviceaccount"), name)
-	}
-	return obj.(*v1.ServiceAccount), nil
+	return corev1listers.NewServiceAccountLister(s.indexer).ServiceAccounts(s.namespace).Get(name)
 }
 
 // StoreToLimitRangeLister helps list limit ranges
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.LimitRangeLister instead.
 type StoreToLimitRangeLister struct {
-	Indexer cache.Indexer
-}
-
-func (s *StoreToLimitRangeLister) List(selector labels.Selector) (ret []*v1.LimitRange, err error) {
-	err = cache.ListAll(s.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.LimitRange))
-	})
-	return ret, err
+	Indexer  cache.Indexer
+	informer cache.SharedIndexInformer
 }
 
-// StoreToPersistentVolumeClaimLister helps list pvcs
-type StoreToPersistentVolumeClaimLister struct {
-	Indexer cache.Indexer
+// NewStoreToLimitRangeListerFromInformer attaches informer to the returned
+// lister so that, in addition to List/LimitRanges, it can also be passed to
+// controller code that wants change notifications via Subscribe.
+func NewStoreToLimitRangeListerFromInformer(informer cache.SharedIndexInformer) *StoreToLimitRangeLister {
+	return &StoreToLimitRangeLister{Indexer: informer.GetIndexer(), informer: informer}
 }
 
-// List returns all persistentvolumeclaims that match the specified selector
-func (s *StoreToPersistentVolumeClaimLister) List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error) {
-	err = cache.ListAll(s.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.PersistentVolumeClaim))
-	})
-	return ret, err
+func (s *StoreToLimitRangeLister) List(selector labels.Selector) (ret []*v1.LimitRange, err error) {
+	return corev1listers.NewLimitRangeLister(s.Indexer).List(selector)
 }
 
 func (s *StoreToLimitRangeLister) LimitRanges(namespace string) storeLimitRangesNamespacer {
 	return storeLimitRangesNamespacer{s.Indexer, namespace}
 }
 
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if s was built with NewStoreToLimitRangeListerFromInformer;
+// otherwise it is a no-op that returns nil.
+func (s *StoreToLimitRangeLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
 type storeLimitRangesNamespacer struct {
 	indexer   cache.Indexer
 	namespace string
 }
 
 func (s storeLimitRangesNamespacer) List(selector labels.Selector) (ret []*v1.LimitRange, err error) {
-	err = cache.ListAllByNamespace(s.indexer, s.namespace, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.LimitRange))
-	})
-	return ret, err
+	return corev1listers.NewLimitRangeLister(s.indexer).LimitRanges(s.namespace).List(selector)
 }
 
 func (s storeLimitRangesNamespacer) Get(name string) (*v1.LimitRange, error) {
-	obj, exists, err := s.indexer.GetByKey(s.namespace + "/" + name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("limitrange"), name)
-	}
-	return obj.(*v1.LimitRange), nil
+	return corev1listers.NewLimitRangeLister(s.indexer).LimitRanges(s.namespace).Get(name)
+}
+
+// StoreToPersistentVolumeClaimLister helps list pvcs
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.PersistentVolumeClaimLister instead.
+type StoreToPersistentVolumeClaimLister struct {
+	Indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewStoreToPersistentVolumeClaimListerFromInformer attaches informer to the
+// returned lister so that, in addition to List/PersistentVolumeClaims, it
+// can also be passed to controller code that wants change notifications via
+// Subscribe.
+func NewStoreToPersistentVolumeClaimListerFromInformer(informer cache.SharedIndexInformer) *StoreToPersistentVolumeClaimLister {
+	return &StoreToPersistentVolumeClaimLister{Indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List returns all persistentvolumeclaims that match the specified selector
+func (s *StoreToPersistentVolumeClaimLister) List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error) {
+	return corev1listers.NewPersistentVolumeClaimLister(s.Indexer).List(selector)
 }
 
 // PersistentVolumeClaims returns all claims in a specified namespace.
@@ -303,49 +353,77 @@ func (s *StoreToPersistentVolumeClaimLister) PersistentVolumeClaims(namespace st
 	return storePersistentVolumeClaimsNamespacer{Indexer: s.Indexer, namespace: namespace}
 }
 
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if s was built with
+// NewStoreToPersistentVolumeClaimListerFromInformer; otherwise it is a no-op
+// that returns nil.
+func (s *StoreToPersistentVolumeClaimLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// NewStoreToPersistentVolumeClaimListerWithFieldIndexes returns a
+// StoreToPersistentVolumeClaimLister whose Indexer has the
+// "spec.volumeName" index GetByBoundVolume uses registered on it.
+func NewStoreToPersistentVolumeClaimListerWithFieldIndexes(indexer cache.Indexer) (*StoreToPersistentVolumeClaimLister, error) {
+	if _, err := corev1listers.NewPersistentVolumeClaimListerWithFieldIndexes(indexer); err != nil {
+		return nil, err
+	}
+	return &StoreToPersistentVolumeClaimLister{Indexer: indexer}, nil
+}
+
+// GetByBoundVolume returns the persistentvolumeclaim bound to pvName. See
+// corev1listers.PersistentVolumeClaimLister.GetByBoundVolume for the
+// index/fallback behavior.
+func (s *StoreToPersistentVolumeClaimLister) GetByBoundVolume(pvName string) (*v1.PersistentVolumeClaim, error) {
+	return corev1listers.NewPersistentVolumeClaimLister(s.Indexer).GetByBoundVolume(pvName)
+}
+
 type storePersistentVolumeClaimsNamespacer struct {
 	Indexer   cache.Indexer
 	namespace string
 }
 
 func (s storePersistentVolumeClaimsNamespacer) List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error) {
-	err = cache.ListAllByNamespace(s.Indexer, s.namespace, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.PersistentVolumeClaim))
-	})
-	return ret, err
+	return corev1listers.NewPersistentVolumeClaimLister(s.Indexer).PersistentVolumeClaims(s.namespace).List(selector)
 }
 
 func (s storePersistentVolumeClaimsNamespacer) Get(name string) (*v1.PersistentVolumeClaim, error) {
-	obj, exists, err := s.Indexer.GetByKey(s.namespace + "/" + name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("persistentvolumeclaims"), name)
-	}
-	return obj.(*v1.PersistentVolumeClaim), nil
+	return corev1listers.NewPersistentVolumeClaimLister(s.Indexer).PersistentVolumeClaims(s.namespace).Get(name)
 }
 
 // IndexerToNamespaceLister gives an Indexer List method
+//
+// Deprecated: use k8s.io/kubernetes/pkg/client/listers/core/v1.NamespaceLister instead.
 type IndexerToNamespaceLister struct {
 	cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewIndexerToNamespaceListerFromInformer attaches informer to the returned
+// lister so that, in addition to List/Get, it can also be passed to
+// controller code that wants change notifications via Subscribe.
+func NewIndexerToNamespaceListerFromInformer(informer cache.SharedIndexInformer) *IndexerToNamespaceLister {
+	return &IndexerToNamespaceLister{Indexer: informer.GetIndexer(), informer: informer}
 }
 
 // List returns a list of namespaces
 func (i *IndexerToNamespaceLister) List(selector labels.Selector) (ret []*v1.Namespace, err error) {
-	err = cache.ListAll(i.Indexer, selector, func(m interface{}) {
-		ret = append(ret, m.(*v1.Namespace))
-	})
-	return ret, err
+	return corev1listers.NewNamespaceLister(i.Indexer).List(selector)
 }
 
 func (i *IndexerToNamespaceLister) Get(name string) (*v1.Namespace, error) {
-	obj, exists, err := i.Indexer.GetByKey(name)
-	if err != nil {
-		return nil, err
-	}
-	if !exists {
-		return nil, errors.NewNotFound(api.Resource("namespace"), name)
+	return corev1listers.NewNamespaceLister(i.Indexer).Get(name)
+}
+
+// Subscribe registers handler for add/update/delete notifications. It only
+// works if i was built with NewIndexerToNamespaceListerFromInformer;
+// otherwise it is a no-op that returns nil.
+func (i *IndexerToNamespaceLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if i.informer == nil {
+		return nil
 	}
-	return obj.(*v1.Namespace), nil
+	return i.informer.AddEventHandler(handler)
 }
@@ -0,0 +1,30 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package listers
+
+import "testing"
+
+// TestStoreToPodListerSubscribeWithoutInformerIsNoOp is a regression test:
+// a StoreToPodLister built via the plain struct literal (the only option
+// before NewStoreToPodListerFromInformer existed) has a nil informer, and
+// Subscribe must stay a harmless no-op for that case rather than panic.
+func TestStoreToPodListerSubscribeWithoutInformerIsNoOp(t *testing.T) {
+	s := &StoreToPodLister{}
+	if got := s.Subscribe(nil); got != nil {
+		t.Errorf("expected a nil Registration without an informer, got %v", got)
+	}
+}
@@ -0,0 +1,26 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package v1 has the automatically generated listers for the core v1 API group.
+//
+// Each lister and namespace lister pair below is generated by
+// cmd/listers-gen from the resource list in its JSON descriptor (see
+// hack/listers-gen/*.json), not from markers on the API types themselves;
+// run `hack/update-listers.sh`, which regenerates from every descriptor, after
+// adding or changing an entry in one of them. Hand-written
+// helpers live alongside the generated code in the matching
+// `*_expansion.go` file and are preserved across regeneration.
+package v1
@@ -0,0 +1,40 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+package v1
+
+// PodNamespaceListerExpansion allows custom methods to be added to PodNamespaceLister.
+type PodNamespaceListerExpansion interface{}
+
+// ServiceAccountListerExpansion allows custom methods to be added to ServiceAccountLister.
+type ServiceAccountListerExpansion interface{}
+
+// ServiceAccountNamespaceListerExpansion allows custom methods to be added to ServiceAccountNamespaceLister.
+type ServiceAccountNamespaceListerExpansion interface{}
+
+// LimitRangeListerExpansion allows custom methods to be added to LimitRangeLister.
+type LimitRangeListerExpansion interface{}
+
+// LimitRangeNamespaceListerExpansion allows custom methods to be added to LimitRangeNamespaceLister.
+type LimitRangeNamespaceListerExpansion interface{}
+
+// PersistentVolumeClaimNamespaceListerExpansion allows custom methods to be added to PersistentVolumeClaimNamespaceLister.
+type PersistentVolumeClaimNamespaceListerExpansion interface{}
+
+// NamespaceListerExpansion allows custom methods to be added to NamespaceLister.
+type NamespaceListerExpansion interface{}
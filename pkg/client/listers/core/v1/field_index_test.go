@@ -0,0 +1,98 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"testing"
+
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+func TestNodeNameIndexFunc(t *testing.T) {
+	pod := &v1.Pod{Spec: v1.PodSpec{NodeName: "node-1"}}
+	values, err := NodeNameIndexFunc(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values) != 1 || values[0] != "node-1" {
+		t.Errorf("expected [node-1], got %v", values)
+	}
+
+	if _, err := NodeNameIndexFunc(&v1.Service{}); err == nil {
+		t.Errorf("expected an error for a non-Pod object")
+	}
+}
+
+func TestPodPhaseIndexFunc(t *testing.T) {
+	pod := &v1.Pod{Status: v1.PodStatus{Phase: v1.PodRunning}}
+	values, err := PodPhaseIndexFunc(pod)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(values) != 1 || values[0] != string(v1.PodRunning) {
+		t.Errorf("expected [%s], got %v", v1.PodRunning, values)
+	}
+}
+
+// fakeFieldIndexer is a minimal Indexer sufficient to exercise the
+// idempotency check in NewPodListerWithFieldIndexes/
+// NewPersistentVolumeClaimListerWithFieldIndexes: only GetIndexers and
+// AddIndexers are called by those constructors.
+type fakeFieldIndexer struct {
+	cache.Indexer // unused methods panic if called; the constructors under test don't call them
+	indexers      cache.Indexers
+	addIndexerErr error
+	addCalls      int
+}
+
+func (f *fakeFieldIndexer) GetIndexers() cache.Indexers { return f.indexers }
+
+func (f *fakeFieldIndexer) AddIndexers(newIndexers cache.Indexers) error {
+	f.addCalls++
+	if f.addIndexerErr != nil {
+		return f.addIndexerErr
+	}
+	if f.indexers == nil {
+		f.indexers = cache.Indexers{}
+	}
+	for name, fn := range newIndexers {
+		f.indexers[name] = fn
+	}
+	return nil
+}
+
+// TestNewPodListerWithFieldIndexesIsIdempotent is a regression test: calling
+// the constructor a second time on an indexer that already has both
+// NodeNameIndex and PodPhaseIndex registered must not call AddIndexers
+// again, since AddIndexers errors on a duplicate indexer name.
+func TestNewPodListerWithFieldIndexesIsIdempotent(t *testing.T) {
+	f := &fakeFieldIndexer{}
+	if _, err := NewPodListerWithFieldIndexes(f); err != nil {
+		t.Fatalf("first call: %v", err)
+	}
+	if f.addCalls != 1 {
+		t.Fatalf("expected exactly one AddIndexers call on first use, got %d", f.addCalls)
+	}
+
+	if _, err := NewPodListerWithFieldIndexes(f); err != nil {
+		t.Fatalf("second call: %v", err)
+	}
+	if f.addCalls != 1 {
+		t.Errorf("second call should not have registered already-present indexes again, AddIndexers called %d times", f.addCalls)
+	}
+}
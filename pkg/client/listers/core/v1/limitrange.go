@@ -0,0 +1,119 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// LimitRangeLister helps list LimitRanges.
+type LimitRangeLister interface {
+	// List lists all LimitRanges in the indexer.
+	List(selector labels.Selector) (ret []*v1.LimitRange, err error)
+	// LimitRanges returns an object that can list and get LimitRanges in a given namespace.
+	LimitRanges(namespace string) LimitRangeNamespaceLister
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with NewLimitRangeListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	LimitRangeListerExpansion
+}
+
+// limitRangeLister implements LimitRangeLister.
+type limitRangeLister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewLimitRangeLister returns a new LimitRangeLister.
+func NewLimitRangeLister(indexer cache.Indexer) LimitRangeLister {
+	return &limitRangeLister{indexer: indexer}
+}
+
+// NewLimitRangeListerFromInformer returns a new LimitRangeLister backed by informer,
+// so the same object can be used for both point-in-time reads (List, LimitRanges)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func NewLimitRangeListerFromInformer(informer cache.SharedIndexInformer) LimitRangeLister {
+	return &limitRangeLister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all LimitRanges in the indexer.
+func (s *limitRangeLister) List(selector labels.Selector) (ret []*v1.LimitRange, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("limitrange")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.LimitRange))
+	}
+	return ret, nil
+}
+
+// LimitRanges returns an object that can list and get LimitRanges in a given namespace.
+func (s *limitRangeLister) LimitRanges(namespace string) LimitRangeNamespaceLister {
+	return limitRangeNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with NewLimitRangeLister instead.
+func (s *limitRangeLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// LimitRangeNamespaceLister helps list and get LimitRanges in a given namespace.
+type LimitRangeNamespaceLister interface {
+	// List lists all LimitRanges in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.LimitRange, err error)
+	// Get retrieves the LimitRange from the indexer for a given namespace and name.
+	Get(name string) (*v1.LimitRange, error)
+	LimitRangeNamespaceListerExpansion
+}
+
+// limitRangeNamespaceLister implements LimitRangeNamespaceLister.
+type limitRangeNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all LimitRanges in the indexer for a given namespace.
+func (s limitRangeNamespaceLister) List(selector labels.Selector) (ret []*v1.LimitRange, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("limitrange")).ByNamespace(s.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.LimitRange))
+	}
+	return ret, nil
+}
+
+// Get retrieves the LimitRange from the indexer for a given namespace and name.
+func (s limitRangeNamespaceLister) Get(name string) (*v1.LimitRange, error) {
+	obj, err := cache.NewGenericLister(s.indexer, v1.Resource("limitrange")).ByNamespace(s.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.LimitRange), nil
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// NamespaceLister helps list Namespaces.
+// Namespaces are cluster-scoped, so there is no per-namespace lister.
+type NamespaceLister interface {
+	// List lists all Namespaces in the indexer.
+	List(selector labels.Selector) (ret []*v1.Namespace, err error)
+	// Get retrieves the Namespace from the indexer for a given name.
+	Get(name string) (*v1.Namespace, error)
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with NewNamespaceListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	NamespaceListerExpansion
+}
+
+// namespaceLister implements NamespaceLister.
+type namespaceLister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewNamespaceLister returns a new NamespaceLister.
+func NewNamespaceLister(indexer cache.Indexer) NamespaceLister {
+	return &namespaceLister{indexer: indexer}
+}
+
+// NewNamespaceListerFromInformer returns a new NamespaceLister backed by informer,
+// so the same object can be used for both point-in-time reads (List, Get)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func NewNamespaceListerFromInformer(informer cache.SharedIndexInformer) NamespaceLister {
+	return &namespaceLister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all Namespaces in the indexer.
+func (s *namespaceLister) List(selector labels.Selector) (ret []*v1.Namespace, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("namespace")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.Namespace))
+	}
+	return ret, nil
+}
+
+// Get retrieves the Namespace from the indexer for a given name.
+func (s *namespaceLister) Get(name string) (*v1.Namespace, error) {
+	obj, err := cache.NewGenericLister(s.indexer, v1.Resource("namespace")).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Namespace), nil
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with NewNamespaceLister instead.
+func (s *namespaceLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
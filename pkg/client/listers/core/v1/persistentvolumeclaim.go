@@ -0,0 +1,119 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// PersistentVolumeClaimLister helps list PersistentVolumeClaims.
+type PersistentVolumeClaimLister interface {
+	// List lists all PersistentVolumeClaims in the indexer.
+	List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error)
+	// PersistentVolumeClaims returns an object that can list and get PersistentVolumeClaims in a given namespace.
+	PersistentVolumeClaims(namespace string) PersistentVolumeClaimNamespaceLister
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with NewPersistentVolumeClaimListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	PersistentVolumeClaimListerExpansion
+}
+
+// persistentVolumeClaimLister implements PersistentVolumeClaimLister.
+type persistentVolumeClaimLister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewPersistentVolumeClaimLister returns a new PersistentVolumeClaimLister.
+func NewPersistentVolumeClaimLister(indexer cache.Indexer) PersistentVolumeClaimLister {
+	return &persistentVolumeClaimLister{indexer: indexer}
+}
+
+// NewPersistentVolumeClaimListerFromInformer returns a new PersistentVolumeClaimLister backed by informer,
+// so the same object can be used for both point-in-time reads (List, PersistentVolumeClaims)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func NewPersistentVolumeClaimListerFromInformer(informer cache.SharedIndexInformer) PersistentVolumeClaimLister {
+	return &persistentVolumeClaimLister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all PersistentVolumeClaims in the indexer.
+func (s *persistentVolumeClaimLister) List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("persistentvolumeclaims")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.PersistentVolumeClaim))
+	}
+	return ret, nil
+}
+
+// PersistentVolumeClaims returns an object that can list and get PersistentVolumeClaims in a given namespace.
+func (s *persistentVolumeClaimLister) PersistentVolumeClaims(namespace string) PersistentVolumeClaimNamespaceLister {
+	return persistentVolumeClaimNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with NewPersistentVolumeClaimLister instead.
+func (s *persistentVolumeClaimLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// PersistentVolumeClaimNamespaceLister helps list and get PersistentVolumeClaims in a given namespace.
+type PersistentVolumeClaimNamespaceLister interface {
+	// List lists all PersistentVolumeClaims in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error)
+	// Get retrieves the PersistentVolumeClaim from the indexer for a given namespace and name.
+	Get(name string) (*v1.PersistentVolumeClaim, error)
+	PersistentVolumeClaimNamespaceListerExpansion
+}
+
+// persistentVolumeClaimNamespaceLister implements PersistentVolumeClaimNamespaceLister.
+type persistentVolumeClaimNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all PersistentVolumeClaims in the indexer for a given namespace.
+func (s persistentVolumeClaimNamespaceLister) List(selector labels.Selector) (ret []*v1.PersistentVolumeClaim, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("persistentvolumeclaims")).ByNamespace(s.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.PersistentVolumeClaim))
+	}
+	return ret, nil
+}
+
+// Get retrieves the PersistentVolumeClaim from the indexer for a given namespace and name.
+func (s persistentVolumeClaimNamespaceLister) Get(name string) (*v1.PersistentVolumeClaim, error) {
+	obj, err := cache.NewGenericLister(s.indexer, v1.Resource("persistentvolumeclaims")).ByNamespace(s.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.PersistentVolumeClaim), nil
+}
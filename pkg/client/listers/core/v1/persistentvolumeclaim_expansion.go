@@ -0,0 +1,97 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// PersistentVolumeClaimListerExpansion allows custom methods to be added to PersistentVolumeClaimLister.
+type PersistentVolumeClaimListerExpansion interface {
+	// GetByBoundVolume returns the PersistentVolumeClaim bound to pvName, if any.
+	GetByBoundVolume(pvName string) (*v1.PersistentVolumeClaim, error)
+}
+
+// VolumeNameIndex is the cache.Indexer index name over a
+// PersistentVolumeClaim's spec.volumeName, registered by
+// NewPersistentVolumeClaimListerWithFieldIndexes.
+const VolumeNameIndex = "spec.volumeName"
+
+// VolumeNameIndexFunc indexes PersistentVolumeClaims by spec.volumeName.
+func VolumeNameIndexFunc(obj interface{}) ([]string, error) {
+	pvc, ok := obj.(*v1.PersistentVolumeClaim)
+	if !ok {
+		return nil, fmt.Errorf("object is not a PersistentVolumeClaim: %v", obj)
+	}
+	if pvc.Spec.VolumeName == "" {
+		return nil, nil
+	}
+	return []string{pvc.Spec.VolumeName}, nil
+}
+
+// NewPersistentVolumeClaimListerWithFieldIndexes returns a
+// PersistentVolumeClaimLister over indexer, having first registered the
+// VolumeNameIndex index that GetByBoundVolume uses. Call this instead of
+// NewPersistentVolumeClaimLister when callers need that lookup. It is
+// idempotent against being called more than once with the same indexer: an
+// already-registered VolumeNameIndex is left alone rather than re-added. It
+// still returns whatever error indexer.AddIndexers returns if indexer
+// already has objects in it (that constraint isn't something this function
+// can paper over), so this should be called right after the indexer is
+// created, before anything populates it.
+func NewPersistentVolumeClaimListerWithFieldIndexes(indexer cache.Indexer) (PersistentVolumeClaimLister, error) {
+	if _, ok := indexer.GetIndexers()[VolumeNameIndex]; ok {
+		return NewPersistentVolumeClaimLister(indexer), nil
+	}
+	if err := indexer.AddIndexers(cache.Indexers{VolumeNameIndex: VolumeNameIndexFunc}); err != nil {
+		return nil, err
+	}
+	return NewPersistentVolumeClaimLister(indexer), nil
+}
+
+// GetByBoundVolume returns the PersistentVolumeClaim bound to pvName. It
+// uses the VolumeNameIndex registered by
+// NewPersistentVolumeClaimListerWithFieldIndexes when available, falling
+// back to a full scan with a warning so listers built with the plain
+// NewPersistentVolumeClaimLister keep working.
+func (s *persistentVolumeClaimLister) GetByBoundVolume(pvName string) (*v1.PersistentVolumeClaim, error) {
+	objs, err := s.indexer.ByIndex(VolumeNameIndex, pvName)
+	if err != nil {
+		glog.Warningf("GetByBoundVolume: %s index not registered, falling back to a full scan", VolumeNameIndex)
+		all, err := s.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		for _, pvc := range all {
+			if pvc.Spec.VolumeName == pvName {
+				return pvc, nil
+			}
+		}
+		return nil, errors.NewNotFound(v1.Resource("persistentvolumeclaims"), pvName)
+	}
+	for _, obj := range objs {
+		return obj.(*v1.PersistentVolumeClaim), nil
+	}
+	return nil, errors.NewNotFound(v1.Resource("persistentvolumeclaims"), pvName)
+}
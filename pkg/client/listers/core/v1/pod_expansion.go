@@ -0,0 +1,141 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// PodListerExpansion allows custom methods to be added to PodLister.
+type PodListerExpansion interface {
+	// ListByNode lists every Pod with spec.nodeName == nodeName.
+	ListByNode(nodeName string) ([]*v1.Pod, error)
+	// ListByPhase lists every Pod whose status.phase == phase.
+	ListByPhase(phase v1.PodPhase) ([]*v1.Pod, error)
+}
+
+const (
+	// NodeNameIndex is the cache.Indexer index name over a Pod's
+	// spec.nodeName, registered by NewPodListerWithFieldIndexes.
+	NodeNameIndex = "spec.nodeName"
+	// PodPhaseIndex is the cache.Indexer index name over a Pod's
+	// status.phase, registered by NewPodListerWithFieldIndexes.
+	PodPhaseIndex = "status.phase"
+)
+
+// NodeNameIndexFunc indexes Pods by spec.nodeName.
+func NodeNameIndexFunc(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("object is not a Pod: %v", obj)
+	}
+	return []string{pod.Spec.NodeName}, nil
+}
+
+// PodPhaseIndexFunc indexes Pods by status.phase.
+func PodPhaseIndexFunc(obj interface{}) ([]string, error) {
+	pod, ok := obj.(*v1.Pod)
+	if !ok {
+		return nil, fmt.Errorf("object is not a Pod: %v", obj)
+	}
+	return []string{string(pod.Status.Phase)}, nil
+}
+
+// NewPodListerWithFieldIndexes returns a PodLister over indexer, having
+// first registered the NodeNameIndex and PodPhaseIndex indexes that
+// ListByNode and ListByPhase use. Call this instead of NewPodLister when
+// callers need those lookups. It is idempotent against being called more
+// than once with the same indexer: indexes already registered by name are
+// left alone rather than re-added. It still returns whatever error
+// indexer.AddIndexers returns if indexer already has objects in it (that
+// constraint isn't something this function can paper over), so this should
+// be called right after the indexer is created, before anything populates
+// it.
+func NewPodListerWithFieldIndexes(indexer cache.Indexer) (PodLister, error) {
+	existing := indexer.GetIndexers()
+	toAdd := cache.Indexers{}
+	if _, ok := existing[NodeNameIndex]; !ok {
+		toAdd[NodeNameIndex] = NodeNameIndexFunc
+	}
+	if _, ok := existing[PodPhaseIndex]; !ok {
+		toAdd[PodPhaseIndex] = PodPhaseIndexFunc
+	}
+	if len(toAdd) > 0 {
+		if err := indexer.AddIndexers(toAdd); err != nil {
+			return nil, err
+		}
+	}
+	return NewPodLister(indexer), nil
+}
+
+// ListByNode lists every Pod with spec.nodeName == nodeName. It uses the
+// NodeNameIndex registered by NewPodListerWithFieldIndexes when available,
+// falling back to a full scan with a warning so listers built with the
+// plain NewPodLister keep working.
+func (s *podLister) ListByNode(nodeName string) ([]*v1.Pod, error) {
+	objs, err := s.indexer.ByIndex(NodeNameIndex, nodeName)
+	if err != nil {
+		glog.Warningf("ListByNode: %s index not registered, falling back to a full scan", NodeNameIndex)
+		all, err := s.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		var pods []*v1.Pod
+		for _, pod := range all {
+			if pod.Spec.NodeName == nodeName {
+				pods = append(pods, pod)
+			}
+		}
+		return pods, nil
+	}
+	pods := make([]*v1.Pod, 0, len(objs))
+	for _, obj := range objs {
+		pods = append(pods, obj.(*v1.Pod))
+	}
+	return pods, nil
+}
+
+// ListByPhase lists every Pod whose status.phase == phase. See ListByNode
+// for the index/fallback behavior.
+func (s *podLister) ListByPhase(phase v1.PodPhase) ([]*v1.Pod, error) {
+	objs, err := s.indexer.ByIndex(PodPhaseIndex, string(phase))
+	if err != nil {
+		glog.Warningf("ListByPhase: %s index not registered, falling back to a full scan", PodPhaseIndex)
+		all, err := s.List(labels.Everything())
+		if err != nil {
+			return nil, err
+		}
+		var pods []*v1.Pod
+		for _, pod := range all {
+			if pod.Status.Phase == phase {
+				pods = append(pods, pod)
+			}
+		}
+		return pods, nil
+	}
+	pods := make([]*v1.Pod, 0, len(objs))
+	for _, obj := range objs {
+		pods = append(pods, obj.(*v1.Pod))
+	}
+	return pods, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// ReplicationControllerLister helps list ReplicationControllers.
+type ReplicationControllerLister interface {
+	// List lists all ReplicationControllers in the indexer.
+	List(selector labels.Selector) (ret []*v1.ReplicationController, err error)
+	// ReplicationControllers returns an object that can list and get ReplicationControllers in a given namespace.
+	ReplicationControllers(namespace string) ReplicationControllerNamespaceLister
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with NewReplicationControllerListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	ReplicationControllerListerExpansion
+}
+
+// replicationControllerLister implements ReplicationControllerLister.
+type replicationControllerLister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewReplicationControllerLister returns a new ReplicationControllerLister.
+func NewReplicationControllerLister(indexer cache.Indexer) ReplicationControllerLister {
+	return &replicationControllerLister{indexer: indexer}
+}
+
+// NewReplicationControllerListerFromInformer returns a new ReplicationControllerLister backed by informer,
+// so the same object can be used for both point-in-time reads (List, ReplicationControllers)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func NewReplicationControllerListerFromInformer(informer cache.SharedIndexInformer) ReplicationControllerLister {
+	return &replicationControllerLister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all ReplicationControllers in the indexer.
+func (s *replicationControllerLister) List(selector labels.Selector) (ret []*v1.ReplicationController, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("replicationcontroller")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.ReplicationController))
+	}
+	return ret, nil
+}
+
+// ReplicationControllers returns an object that can list and get ReplicationControllers in a given namespace.
+func (s *replicationControllerLister) ReplicationControllers(namespace string) ReplicationControllerNamespaceLister {
+	return replicationControllerNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with NewReplicationControllerLister instead.
+func (s *replicationControllerLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// ReplicationControllerNamespaceLister helps list and get ReplicationControllers in a given namespace.
+type ReplicationControllerNamespaceLister interface {
+	// List lists all ReplicationControllers in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.ReplicationController, err error)
+	// Get retrieves the ReplicationController from the indexer for a given namespace and name.
+	Get(name string) (*v1.ReplicationController, error)
+	ReplicationControllerNamespaceListerExpansion
+}
+
+// replicationControllerNamespaceLister implements ReplicationControllerNamespaceLister.
+type replicationControllerNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all ReplicationControllers in the indexer for a given namespace.
+func (s replicationControllerNamespaceLister) List(selector labels.Selector) (ret []*v1.ReplicationController, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("replicationcontroller")).ByNamespace(s.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.ReplicationController))
+	}
+	return ret, nil
+}
+
+// Get retrieves the ReplicationController from the indexer for a given namespace and name.
+func (s replicationControllerNamespaceLister) Get(name string) (*v1.ReplicationController, error) {
+	obj, err := cache.NewGenericLister(s.indexer, v1.Resource("replicationcontroller")).ByNamespace(s.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.ReplicationController), nil
+}
@@ -0,0 +1,128 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// ReplicationControllerListerExpansion allows custom methods to be added to ReplicationControllerLister.
+type ReplicationControllerListerExpansion interface {
+	GetPodControllers(pod *v1.Pod) ([]*v1.ReplicationController, error)
+	GetPodControllersIndexed(pod *v1.Pod) ([]*v1.ReplicationController, error)
+}
+
+// ReplicationControllerNamespaceListerExpansion allows custom methods to be added to ReplicationControllerNamespaceLister.
+type ReplicationControllerNamespaceListerExpansion interface{}
+
+// ReplicationControllerSelectorIndexFunc extracts a ReplicationController's
+// spec.selector as a labels.Selector for cache.NewSelectorIndex.
+func ReplicationControllerSelectorIndexFunc(obj interface{}) labels.Selector {
+	rc, ok := obj.(*v1.ReplicationController)
+	if !ok || rc.Spec.Selector == nil {
+		return nil
+	}
+	return labels.Set(rc.Spec.Selector).AsSelectorPreValidated()
+}
+
+// NewReplicationControllerListerWithSelectorIndex returns a
+// ReplicationControllerLister over indexer, wrapped in a cache.SelectorIndex
+// so GetPodControllersIndexed can look ReplicationControllers up by the
+// pod's labels instead of scanning every ReplicationController in the
+// namespace.
+func NewReplicationControllerListerWithSelectorIndex(indexer cache.Indexer) ReplicationControllerLister {
+	return NewReplicationControllerLister(cache.NewSelectorIndex(indexer, ReplicationControllerSelectorIndexFunc))
+}
+
+// GetPodControllers returns a list of ReplicationControllers managing a pod.
+// Returns an error only if no matching ReplicationControllers are found.
+func (s *replicationControllerLister) GetPodControllers(pod *v1.Pod) (controllers []*v1.ReplicationController, err error) {
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("no controllers found for pod %v because it has no labels", pod.Name)
+		return
+	}
+
+	key := &v1.ReplicationController{ObjectMeta: metav1.ObjectMeta{Namespace: pod.Namespace}}
+	items, err := s.indexer.Index(cache.NamespaceIndex, key)
+	if err != nil {
+		return
+	}
+
+	for _, m := range items {
+		rc := m.(*v1.ReplicationController)
+		selector := labels.Set(rc.Spec.Selector).AsSelectorPreValidated()
+
+		// If an rc with a nil or empty selector creeps in, it should match nothing, not everything.
+		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		controllers = append(controllers, rc)
+	}
+	if len(controllers) == 0 {
+		err = fmt.Errorf("could not find controller for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
+
+// GetPodControllersIndexed behaves like GetPodControllers, but consults the
+// cache.SelectorIndex on the lister's indexer (if one was installed) instead
+// of scanning every ReplicationController in the namespace. It falls back to
+// GetPodControllers when the indexer isn't a cache.SelectorIndexer.
+func (s *replicationControllerLister) GetPodControllersIndexed(pod *v1.Pod) (controllers []*v1.ReplicationController, err error) {
+	if len(pod.Labels) == 0 {
+		err = fmt.Errorf("no controllers found for pod %v because it has no labels", pod.Name)
+		return
+	}
+
+	indexed, ok := s.indexer.(cache.SelectorIndexer)
+	if !ok {
+		return s.GetPodControllers(pod)
+	}
+
+	candidateKeys, err := indexed.MatchingKeysForLabels(labels.Set(pod.Labels))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range candidateKeys.List() {
+		obj, exists, err := s.indexer.GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		rc := obj.(*v1.ReplicationController)
+		if rc.Namespace != pod.Namespace {
+			continue
+		}
+		selector := labels.Set(rc.Spec.Selector).AsSelectorPreValidated()
+		if selector.Empty() || !selector.Matches(labels.Set(pod.Labels)) {
+			continue
+		}
+		controllers = append(controllers, rc)
+	}
+	if len(controllers) == 0 {
+		err = fmt.Errorf("could not find controller for pod %s in namespace %s with labels: %v", pod.Name, pod.Namespace, pod.Labels)
+	}
+	return
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file was automatically generated by listers-gen
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// ServiceLister helps list Services.
+type ServiceLister interface {
+	// List lists all Services in the indexer.
+	List(selector labels.Selector) (ret []*v1.Service, err error)
+	// Services returns an object that can list and get Services in a given namespace.
+	Services(namespace string) ServiceNamespaceLister
+	// Subscribe registers handler for add/update/delete notifications. It
+	// only works if the lister was built with NewServiceListerFromInformer;
+	// otherwise it is a no-op that returns nil.
+	Subscribe(handler cache.ResourceEventHandler) cache.Registration
+	ServiceListerExpansion
+}
+
+// serviceLister implements ServiceLister.
+type serviceLister struct {
+	indexer  cache.Indexer
+	informer cache.SharedIndexInformer
+}
+
+// NewServiceLister returns a new ServiceLister.
+func NewServiceLister(indexer cache.Indexer) ServiceLister {
+	return &serviceLister{indexer: indexer}
+}
+
+// NewServiceListerFromInformer returns a new ServiceLister backed by informer,
+// so the same object can be used for both point-in-time reads (List, Services)
+// and change notifications (Subscribe), instead of a controller wiring up a
+// separate Reflector/Store/Informer alongside it.
+func NewServiceListerFromInformer(informer cache.SharedIndexInformer) ServiceLister {
+	return &serviceLister{indexer: informer.GetIndexer(), informer: informer}
+}
+
+// List lists all Services in the indexer.
+func (s *serviceLister) List(selector labels.Selector) (ret []*v1.Service, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("service")).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.Service))
+	}
+	return ret, nil
+}
+
+// Services returns an object that can list and get Services in a given namespace.
+func (s *serviceLister) Services(namespace string) ServiceNamespaceLister {
+	return serviceNamespaceLister{indexer: s.indexer, namespace: namespace}
+}
+
+// Subscribe registers handler on the informer this lister was built from. It
+// returns nil if the lister was constructed with NewServiceLister instead.
+func (s *serviceLister) Subscribe(handler cache.ResourceEventHandler) cache.Registration {
+	if s.informer == nil {
+		return nil
+	}
+	return s.informer.AddEventHandler(handler)
+}
+
+// ServiceNamespaceLister helps list and get Services in a given namespace.
+type ServiceNamespaceLister interface {
+	// List lists all Services in the indexer for a given namespace.
+	List(selector labels.Selector) (ret []*v1.Service, err error)
+	// Get retrieves the Service from the indexer for a given namespace and name.
+	Get(name string) (*v1.Service, error)
+	ServiceNamespaceListerExpansion
+}
+
+// serviceNamespaceLister implements ServiceNamespaceLister.
+type serviceNamespaceLister struct {
+	indexer   cache.Indexer
+	namespace string
+}
+
+// List lists all Services in the indexer for a given namespace.
+func (s serviceNamespaceLister) List(selector labels.Selector) (ret []*v1.Service, err error) {
+	objs, err := cache.NewGenericLister(s.indexer, v1.Resource("service")).ByNamespace(s.namespace).List(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		ret = append(ret, obj.(*v1.Service))
+	}
+	return ret, nil
+}
+
+// Get retrieves the Service from the indexer for a given namespace and name.
+func (s serviceNamespaceLister) Get(name string) (*v1.Service, error) {
+	obj, err := cache.NewGenericLister(s.indexer, v1.Resource("service")).ByNamespace(s.namespace).Get(name)
+	if err != nil {
+		return nil, err
+	}
+	return obj.(*v1.Service), nil
+}
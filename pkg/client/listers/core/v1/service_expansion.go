@@ -0,0 +1,116 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// ServiceListerExpansion allows custom methods to be added to ServiceLister.
+type ServiceListerExpansion interface {
+	GetPodServices(pod *v1.Pod) ([]*v1.Service, error)
+	GetPodServicesIndexed(pod *v1.Pod) ([]*v1.Service, error)
+}
+
+// ServiceNamespaceListerExpansion allows custom methods to be added to ServiceNamespaceLister.
+type ServiceNamespaceListerExpansion interface{}
+
+// ServiceSelectorIndexFunc extracts a Service's spec.selector as a
+// labels.Selector for cache.NewSelectorIndex. Services with a nil selector
+// (headless/ExternalName Services) select nothing and are omitted.
+func ServiceSelectorIndexFunc(obj interface{}) labels.Selector {
+	service, ok := obj.(*v1.Service)
+	if !ok || service.Spec.Selector == nil {
+		return nil
+	}
+	return labels.Set(service.Spec.Selector).AsSelectorPreValidated()
+}
+
+// NewServiceListerWithSelectorIndex returns a ServiceLister over indexer,
+// wrapped in a cache.SelectorIndex so GetPodServicesIndexed can look
+// Services up by the pod's labels instead of scanning every Service in the
+// namespace.
+func NewServiceListerWithSelectorIndex(indexer cache.Indexer) ServiceLister {
+	return NewServiceLister(cache.NewSelectorIndex(indexer, ServiceSelectorIndexFunc))
+}
+
+// GetPodServices returns a list of Services that match a Pod. Returns an
+// error only if the list of all Services could not be retrieved.
+//
+// TODO: Move this back to scheduler as a helper function that takes a Store,
+// rather than a method of ServiceLister.
+func (s *serviceLister) GetPodServices(pod *v1.Pod) ([]*v1.Service, error) {
+	allServices, err := s.Services(pod.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var services []*v1.Service
+	for i := range allServices {
+		service := allServices[i]
+		if service.Spec.Selector == nil {
+			// services with nil selectors match nothing, not everything.
+			continue
+		}
+		selector := labels.Set(service.Spec.Selector).AsSelectorPreValidated()
+		if selector.Matches(labels.Set(pod.Labels)) {
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
+
+// GetPodServicesIndexed behaves like GetPodServices, but consults the
+// cache.SelectorIndex on the lister's indexer (if one was installed) to
+// avoid scanning every Service in the namespace. Scheduling is the most
+// selector-index-sensitive caller; it falls back to GetPodServices when the
+// indexer isn't a cache.SelectorIndexer.
+func (s *serviceLister) GetPodServicesIndexed(pod *v1.Pod) ([]*v1.Service, error) {
+	indexed, ok := s.indexer.(cache.SelectorIndexer)
+	if !ok {
+		return s.GetPodServices(pod)
+	}
+
+	candidateKeys, err := indexed.MatchingKeysForLabels(labels.Set(pod.Labels))
+	if err != nil {
+		return nil, err
+	}
+
+	var services []*v1.Service
+	for _, key := range candidateKeys.List() {
+		obj, exists, err := s.indexer.GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		service := obj.(*v1.Service)
+		if service.Namespace != pod.Namespace || service.Spec.Selector == nil {
+			continue
+		}
+		selector := labels.Set(service.Spec.Selector).AsSelectorPreValidated()
+		if selector.Matches(labels.Set(pod.Labels)) {
+			services = append(services, service)
+		}
+	}
+
+	return services, nil
+}
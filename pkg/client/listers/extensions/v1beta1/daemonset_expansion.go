@@ -0,0 +1,121 @@
+/*
+Copyright 2016 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/kubernetes/pkg/api/v1"
+	"k8s.io/kubernetes/pkg/apis/extensions/v1beta1"
+	"k8s.io/kubernetes/pkg/client/cache"
+)
+
+// DaemonSetListerExpansion allows custom methods to be added to DaemonSetLister.
+type DaemonSetListerExpansion interface {
+	GetPodDaemonSets(pod *v1.Pod) ([]*v1beta1.DaemonSet, error)
+}
+
+// DaemonSetNamespaceListerExpansion allows custom methods to be added to DaemonSetNamespaceLister.
+type DaemonSetNamespaceListerExpansion interface{}
+
+// DaemonSetSelectorIndexFunc extracts a DaemonSet's spec.selector as a
+// labels.Selector for cache.NewSelectorIndex. Unlike Service/
+// ReplicationController, spec.selector here is a *metav1.LabelSelector, so
+// the resulting selector may carry Exists/DoesNotExist/NotIn requirements
+// that cache.SelectorIndex can't post as "key=value" pairs; SelectorIndex
+// tracks those separately rather than losing them.
+func DaemonSetSelectorIndexFunc(obj interface{}) labels.Selector {
+	ds, ok := obj.(*v1beta1.DaemonSet)
+	if !ok {
+		return nil
+	}
+	selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+	if err != nil {
+		return nil
+	}
+	return selector
+}
+
+// NewDaemonSetListerWithSelectorIndex returns a DaemonSetLister over
+// indexer, wrapped in a cache.SelectorIndex so GetPodDaemonSets can look
+// DaemonSets up by the pod's labels instead of scanning every DaemonSet in
+// the namespace.
+func NewDaemonSetListerWithSelectorIndex(indexer cache.Indexer) DaemonSetLister {
+	return NewDaemonSetLister(cache.NewSelectorIndex(indexer, DaemonSetSelectorIndexFunc))
+}
+
+// GetPodDaemonSets returns a list of DaemonSets that match a Pod. Returns an
+// error only if the list of all DaemonSets could not be retrieved. Like
+// GetPodServicesIndexed, it consults the lister's cache.SelectorIndex when
+// one is installed instead of scanning every DaemonSet in the namespace.
+func (s *daemonSetLister) GetPodDaemonSets(pod *v1.Pod) ([]*v1beta1.DaemonSet, error) {
+	if indexed, ok := s.indexer.(cache.SelectorIndexer); ok {
+		return s.getPodDaemonSetsIndexed(pod, indexed)
+	}
+	return s.getPodDaemonSetsScan(pod)
+}
+
+func (s *daemonSetLister) getPodDaemonSetsIndexed(pod *v1.Pod, indexed cache.SelectorIndexer) ([]*v1beta1.DaemonSet, error) {
+	candidateKeys, err := indexed.MatchingKeysForLabels(labels.Set(pod.Labels))
+	if err != nil {
+		return nil, err
+	}
+
+	var daemonSets []*v1beta1.DaemonSet
+	for _, key := range candidateKeys.List() {
+		obj, exists, err := s.indexer.GetByKey(key)
+		if err != nil {
+			return nil, err
+		}
+		if !exists {
+			continue
+		}
+		ds := obj.(*v1beta1.DaemonSet)
+		if ds.Namespace != pod.Namespace {
+			continue
+		}
+		selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+		if err != nil || selector.Empty() {
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			daemonSets = append(daemonSets, ds)
+		}
+	}
+	return daemonSets, nil
+}
+
+func (s *daemonSetLister) getPodDaemonSetsScan(pod *v1.Pod) ([]*v1beta1.DaemonSet, error) {
+	allDaemonSets, err := s.DaemonSets(pod.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	var daemonSets []*v1beta1.DaemonSet
+	for i := range allDaemonSets {
+		ds := allDaemonSets[i]
+		selector, err := metav1.LabelSelectorAsSelector(ds.Spec.Selector)
+		if err != nil || selector.Empty() {
+			// daemon sets with nil or empty selectors match nothing, not everything.
+			continue
+		}
+		if selector.Matches(labels.Set(pod.Labels)) {
+			daemonSets = append(daemonSets, ds)
+		}
+	}
+	return daemonSets, nil
+}